@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/prometheus-community/windows_exporter/internal/mi"
@@ -19,15 +20,60 @@ import (
 
 const Name = "dhcp"
 
-type Config struct{}
+const (
+	// MetricGroupPackets covers the DHCP message counters (discovers,
+	// offers, requests, acks, nacks, declines, releases, informs).
+	MetricGroupPackets = "packets"
+	// MetricGroupQueues covers the server's internal processing queue
+	// length gauges.
+	MetricGroupQueues = "queues"
+	// MetricGroupFilters covers the Allow/Deny MAC address filter
+	// counters.
+	MetricGroupFilters = "filters"
+	// MetricGroupFailover covers failover message counters, state
+	// transition counters and the current failover relationship state.
+	MetricGroupFailover = "failover"
+)
+
+// enabledMetricGroups is the set of metric groups collected when the user
+// does not override --collector.dhcp.enabled, preserving the previous
+// behaviour of collecting everything.
+var enabledMetricGroups = []string{
+	MetricGroupPackets,
+	MetricGroupQueues,
+	MetricGroupFilters,
+	MetricGroupFailover,
+}
+
+type Config struct {
+	ScopesEnabled bool `yaml:"scopes_enabled"`
+
+	// EnabledMetrics restricts collection to the named metric groups
+	// (MetricGroupPackets, MetricGroupQueues, MetricGroupFilters,
+	// MetricGroupFailover). A nil/empty slice collects every group.
+	EnabledMetrics []string `yaml:"enabled_metrics"`
+
+	// ScopeUtilizationHistogramEnabled collects a fleet-wide
+	// windows_dhcp_scope_utilization_ratio_histogram across all scopes seen
+	// in a scrape, in addition to the per-scope gauges. Requires
+	// ScopesEnabled.
+	ScopeUtilizationHistogramEnabled bool `yaml:"scope_utilization_histogram_enabled"`
+}
 
-var ConfigDefaults = Config{}
+var ConfigDefaults = Config{
+	ScopesEnabled:                    false,
+	EnabledMetrics:                   enabledMetricGroups,
+	ScopeUtilizationHistogramEnabled: false,
+}
 
 // A Collector is a Prometheus Collector perflib DHCP metrics.
 type Collector struct {
 	config Config
 
-	perfDataCollector perfdata.Collector
+	miSession *mi.Session
+
+	perfDataCollector   perfdata.Collector
+	perfDataCollectorV6 perfdata.Collector
 
 	acksTotal                                        *prometheus.Desc
 	activeQueueLength                                *prometheus.Desc
@@ -54,6 +100,36 @@ type Collector struct {
 	packetsReceivedTotal                             *prometheus.Desc
 	releasesTotal                                    *prometheus.Desc
 	requestsTotal                                    *prometheus.Desc
+
+	scopeAddressesFree     *prometheus.Desc
+	scopeAddressesInUse    *prometheus.Desc
+	scopePercentageInUse   *prometheus.Desc
+	scopeReservedAddresses *prometheus.Desc
+	scopeActiveLeases      *prometheus.Desc
+	scopePendingOffers     *prometheus.Desc
+	scopeUtilizationRatio  *prometheus.Desc
+
+	scopeUtilizationHistogram *prometheus.Desc
+
+	failoverRelationshipState  *prometheus.Desc
+	failoverRelationshipInfo   *prometheus.Desc
+	failoverMCLTSeconds        *prometheus.Desc
+	failoverLoadBalancePercent *prometheus.Desc
+	failoverReservePercent     *prometheus.Desc
+	failoverStateSinceSeconds  *prometheus.Desc
+
+	v6ActiveQueueLength        *prometheus.Desc
+	v6AdvertisesTotal          *prometheus.Desc
+	v6DeclinesTotal            *prometheus.Desc
+	v6DuplicatesDroppedTotal   *prometheus.Desc
+	v6InformationRequestsTotal *prometheus.Desc
+	v6PacketsReceivedTotal     *prometheus.Desc
+	v6RebindsTotal             *prometheus.Desc
+	v6ReleasesTotal            *prometheus.Desc
+	v6RenewsTotal              *prometheus.Desc
+	v6RepliesTotal             *prometheus.Desc
+	v6RequestsTotal            *prometheus.Desc
+	v6SolicitsTotal            *prometheus.Desc
 }
 
 func New(config *Config) *Collector {
@@ -68,8 +144,99 @@ func New(config *Config) *Collector {
 	return c
 }
 
-func NewWithFlags(_ *kingpin.Application) *Collector {
-	return &Collector{}
+func NewWithFlags(app *kingpin.Application) *Collector {
+	c := &Collector{}
+
+	app.Flag(
+		"collector.dhcp.scopes-enabled",
+		"Collect per-scope DHCP lease and pool statistics (addresses in use/free, percentage used, reserved addresses, active leases, pending offers) via Get-DhcpServerv4ScopeStatistics/Get-DhcpServerv6ScopeStatistics. Disabled by default as it is only meaningful on hosts running the DHCP Server role.",
+	).Default("false").BoolVar(&c.config.ScopesEnabled)
+
+	app.Flag(
+		"collector.dhcp.enabled",
+		"Comma-separated list of DHCP metric groups to collect. Repeatable. Available groups: packets, queues, filters, failover. Defaults to all groups.",
+	).SetValue(newCSVStringsValue(&c.config.EnabledMetrics, enabledMetricGroups))
+
+	app.Flag(
+		"collector.dhcp.scope-utilization-histogram-enabled",
+		"Collect a windows_dhcp_scope_utilization_ratio_histogram histogram bucketing every scope seen in a scrape by percent utilization, in addition to the per-scope gauges. Requires --collector.dhcp.scopes-enabled.",
+	).Default("false").BoolVar(&c.config.ScopeUtilizationHistogramEnabled)
+
+	return c
+}
+
+// csvStringsValue is a kingpin.Value that accepts a comma-separated list of
+// strings and is cumulative across repeated flag occurrences, e.g.
+// --collector.dhcp.enabled=packets,queues --collector.dhcp.enabled=failover
+// collects all three groups. Plain kingpin.Strings() only appends one
+// element per occurrence and does not split on commas.
+type csvStringsValue struct {
+	target *[]string
+	set    bool
+}
+
+// newCSVStringsValue returns a csvStringsValue that writes into target,
+// seeded with defaults until the flag is set at least once.
+func newCSVStringsValue(target *[]string, defaults []string) *csvStringsValue {
+	*target = defaults
+
+	return &csvStringsValue{target: target}
+}
+
+func (v *csvStringsValue) Set(s string) error {
+	parts := strings.Split(s, ",")
+	parsed := make([]string, 0, len(parts))
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		parsed = append(parsed, part)
+	}
+
+	if !v.set {
+		*v.target = parsed
+		v.set = true
+
+		return nil
+	}
+
+	*v.target = append(*v.target, parsed...)
+
+	return nil
+}
+
+func (v *csvStringsValue) String() string {
+	if v.target == nil {
+		return ""
+	}
+
+	return strings.Join(*v.target, ",")
+}
+
+// IsCumulative marks this value as repeatable to kingpin, so each
+// --collector.dhcp.enabled occurrence adds to the set instead of replacing
+// it outright.
+func (v *csvStringsValue) IsCumulative() bool {
+	return true
+}
+
+// isMetricGroupEnabled reports whether the named metric group should be
+// collected, per --collector.dhcp.enabled.
+func (c *Collector) isMetricGroupEnabled(group string) bool {
+	if len(c.config.EnabledMetrics) == 0 {
+		return true
+	}
+
+	for _, enabled := range c.config.EnabledMetrics {
+		if enabled == group {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (c *Collector) GetName() string {
@@ -81,41 +248,62 @@ func (c *Collector) GetPerfCounter(_ *slog.Logger) ([]string, error) {
 		return []string{}, nil
 	}
 
-	return []string{"DHCP Server"}, nil
+	return []string{"DHCP Server", "DHCPv6 Server"}, nil
 }
 
 func (c *Collector) Close(_ *slog.Logger) error {
 	return nil
 }
 
-func (c *Collector) Build(_ *slog.Logger, _ *mi.Session) error {
+func (c *Collector) Build(_ *slog.Logger, miSession *mi.Session) error {
+	c.miSession = miSession
+
 	if utils.PDHEnabled() {
-		counters := []string{
-			acksTotal,
-			activeQueueLength,
-			conflictCheckQueueLength,
-			declinesTotal,
-			deniedDueToMatch,
-			deniedDueToNonMatch,
-			discoversTotal,
-			duplicatesDroppedTotal,
-			failoverBndAckReceivedTotal,
-			failoverBndAckSentTotal,
-			failoverBndUpdDropped,
-			failoverBndUpdPendingOutboundQueue,
-			failoverBndUpdReceivedTotal,
-			failoverBndUpdSentTotal,
-			failoverTransitionsCommunicationInterruptedState,
-			failoverTransitionsPartnerDownState,
-			failoverTransitionsRecoverState,
-			informsTotal,
-			nacksTotal,
-			offerQueueLength,
-			offersTotal,
-			packetsExpiredTotal,
-			packetsReceivedTotal,
-			releasesTotal,
-			requestsTotal,
+		var counters []string
+
+		if c.isMetricGroupEnabled(MetricGroupPackets) {
+			counters = append(counters,
+				acksTotal,
+				declinesTotal,
+				discoversTotal,
+				duplicatesDroppedTotal,
+				informsTotal,
+				nacksTotal,
+				offersTotal,
+				packetsExpiredTotal,
+				packetsReceivedTotal,
+				releasesTotal,
+				requestsTotal,
+			)
+		}
+
+		if c.isMetricGroupEnabled(MetricGroupQueues) {
+			counters = append(counters,
+				activeQueueLength,
+				conflictCheckQueueLength,
+				offerQueueLength,
+			)
+		}
+
+		if c.isMetricGroupEnabled(MetricGroupFilters) {
+			counters = append(counters,
+				deniedDueToMatch,
+				deniedDueToNonMatch,
+			)
+		}
+
+		if c.isMetricGroupEnabled(MetricGroupFailover) {
+			counters = append(counters,
+				failoverBndAckReceivedTotal,
+				failoverBndAckSentTotal,
+				failoverBndUpdDropped,
+				failoverBndUpdPendingOutboundQueue,
+				failoverBndUpdReceivedTotal,
+				failoverBndUpdSentTotal,
+				failoverTransitionsCommunicationInterruptedState,
+				failoverTransitionsPartnerDownState,
+				failoverTransitionsRecoverState,
+			)
 		}
 
 		var err error
@@ -126,168 +314,263 @@ func (c *Collector) Build(_ *slog.Logger, _ *mi.Session) error {
 		}
 	}
 
-	c.packetsReceivedTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "packets_received_total"),
-		"Total number of packets received by the DHCP server (PacketsReceivedTotal)",
-		nil,
-		nil,
-	)
-	c.duplicatesDroppedTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "duplicates_dropped_total"),
-		"Total number of duplicate packets received by the DHCP server (DuplicatesDroppedTotal)",
-		nil,
-		nil,
-	)
-	c.packetsExpiredTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "packets_expired_total"),
-		"Total number of packets expired in the DHCP server message queue (PacketsExpiredTotal)",
-		nil,
-		nil,
-	)
-	c.activeQueueLength = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "active_queue_length"),
-		"Number of packets in the processing queue of the DHCP server (ActiveQueueLength)",
-		nil,
-		nil,
-	)
-	c.conflictCheckQueueLength = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "conflict_check_queue_length"),
-		"Number of packets in the DHCP server queue waiting on conflict detection (ping). (ConflictCheckQueueLength)",
-		nil,
-		nil,
-	)
-	c.discoversTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "discovers_total"),
-		"Total DHCP Discovers received by the DHCP server (DiscoversTotal)",
-		nil,
-		nil,
-	)
-	c.offersTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "offers_total"),
-		"Total DHCP Offers sent by the DHCP server (OffersTotal)",
-		nil,
-		nil,
-	)
-	c.requestsTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "requests_total"),
-		"Total DHCP Requests received by the DHCP server (RequestsTotal)",
-		nil,
-		nil,
-	)
-	c.informsTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "informs_total"),
-		"Total DHCP Informs received by the DHCP server (InformsTotal)",
-		nil,
-		nil,
-	)
-	c.acksTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "acks_total"),
-		"Total DHCP Acks sent by the DHCP server (AcksTotal)",
-		nil,
-		nil,
-	)
-	c.nACKsTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "nacks_total"),
-		"Total DHCP Nacks sent by the DHCP server (NacksTotal)",
-		nil,
-		nil,
-	)
-	c.declinesTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "declines_total"),
-		"Total DHCP Declines received by the DHCP server (DeclinesTotal)",
-		nil,
-		nil,
-	)
-	c.releasesTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "releases_total"),
-		"Total DHCP Releases received by the DHCP server (ReleasesTotal)",
-		nil,
-		nil,
-	)
-	c.offerQueueLength = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "offer_queue_length"),
-		"Number of packets in the offer queue of the DHCP server (OfferQueueLength)",
-		nil,
-		nil,
-	)
-	c.deniedDueToMatch = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "denied_due_to_match_total"),
-		"Total number of DHCP requests denied, based on matches from the Deny list (DeniedDueToMatch)",
-		nil,
-		nil,
-	)
-	c.deniedDueToNonMatch = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "denied_due_to_nonmatch_total"),
-		"Total number of DHCP requests denied, based on non-matches from the Allow list (DeniedDueToNonMatch)",
-		nil,
-		nil,
-	)
-	c.failoverBndUpdSentTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_sent_total"),
-		"Number of DHCP fail over Binding Update messages sent (FailoverBndupdSentTotal)",
-		nil,
-		nil,
-	)
-	c.failoverBndUpdReceivedTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_received_total"),
-		"Number of DHCP fail over Binding Update messages received (FailoverBndupdReceivedTotal)",
-		nil,
-		nil,
-	)
-	c.failoverBndAckSentTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndack_sent_total"),
-		"Number of DHCP fail over Binding Ack messages sent (FailoverBndackSentTotal)",
-		nil,
-		nil,
-	)
-	c.failoverBndAckReceivedTotal = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndack_received_total"),
-		"Number of DHCP fail over Binding Ack messages received (FailoverBndackReceivedTotal)",
-		nil,
-		nil,
-	)
-	c.failoverBndUpdPendingOutboundQueue = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_pending_in_outbound_queue"),
-		"Number of pending outbound DHCP fail over Binding Update messages (FailoverBndupdPendingOutboundQueue)",
-		nil,
-		nil,
-	)
-	c.failoverTransitionsCommunicationInterruptedState = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_communicationinterrupted_state_total"),
-		"Total number of transitions into COMMUNICATION INTERRUPTED state (FailoverTransitionsCommunicationinterruptedState)",
-		nil,
-		nil,
-	)
-	c.failoverTransitionsPartnerDownState = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_partnerdown_state_total"),
-		"Total number of transitions into PARTNER DOWN state (FailoverTransitionsPartnerdownState)",
-		nil,
-		nil,
-	)
-	c.failoverTransitionsRecoverState = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_recover_total"),
-		"Total number of transitions into RECOVER state (FailoverTransitionsRecoverState)",
-		nil,
-		nil,
-	)
-	c.failoverBndUpdDropped = prometheus.NewDesc(
-		prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_dropped_total"),
-		"Total number of DHCP fail over Binding Updates dropped (FailoverBndupdDropped)",
-		nil,
-		nil,
-	)
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		c.packetsReceivedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "packets_received_total"),
+			"Total number of packets received by the DHCP server (PacketsReceivedTotal)",
+			nil,
+			nil,
+		)
+		c.duplicatesDroppedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "duplicates_dropped_total"),
+			"Total number of duplicate packets received by the DHCP server (DuplicatesDroppedTotal)",
+			nil,
+			nil,
+		)
+		c.packetsExpiredTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "packets_expired_total"),
+			"Total number of packets expired in the DHCP server message queue (PacketsExpiredTotal)",
+			nil,
+			nil,
+		)
+		c.discoversTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "discovers_total"),
+			"Total DHCP Discovers received by the DHCP server (DiscoversTotal)",
+			nil,
+			nil,
+		)
+		c.offersTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "offers_total"),
+			"Total DHCP Offers sent by the DHCP server (OffersTotal)",
+			nil,
+			nil,
+		)
+		c.requestsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "requests_total"),
+			"Total DHCP Requests received by the DHCP server (RequestsTotal)",
+			nil,
+			nil,
+		)
+		c.informsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "informs_total"),
+			"Total DHCP Informs received by the DHCP server (InformsTotal)",
+			nil,
+			nil,
+		)
+		c.acksTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "acks_total"),
+			"Total DHCP Acks sent by the DHCP server (AcksTotal)",
+			nil,
+			nil,
+		)
+		c.nACKsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "nacks_total"),
+			"Total DHCP Nacks sent by the DHCP server (NacksTotal)",
+			nil,
+			nil,
+		)
+		c.declinesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "declines_total"),
+			"Total DHCP Declines received by the DHCP server (DeclinesTotal)",
+			nil,
+			nil,
+		)
+		c.releasesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "releases_total"),
+			"Total DHCP Releases received by the DHCP server (ReleasesTotal)",
+			nil,
+			nil,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		c.activeQueueLength = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "active_queue_length"),
+			"Number of packets in the processing queue of the DHCP server (ActiveQueueLength)",
+			nil,
+			nil,
+		)
+		c.conflictCheckQueueLength = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "conflict_check_queue_length"),
+			"Number of packets in the DHCP server queue waiting on conflict detection (ping). (ConflictCheckQueueLength)",
+			nil,
+			nil,
+		)
+		c.offerQueueLength = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "offer_queue_length"),
+			"Number of packets in the offer queue of the DHCP server (OfferQueueLength)",
+			nil,
+			nil,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFilters) {
+		c.deniedDueToMatch = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "denied_due_to_match_total"),
+			"Total number of DHCP requests denied, based on matches from the Deny list (DeniedDueToMatch)",
+			nil,
+			nil,
+		)
+		c.deniedDueToNonMatch = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "denied_due_to_nonmatch_total"),
+			"Total number of DHCP requests denied, based on non-matches from the Allow list (DeniedDueToNonMatch)",
+			nil,
+			nil,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFailover) {
+		c.failoverBndUpdSentTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_sent_total"),
+			"Number of DHCP fail over Binding Update messages sent (FailoverBndupdSentTotal)",
+			nil,
+			nil,
+		)
+		c.failoverBndUpdReceivedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_received_total"),
+			"Number of DHCP fail over Binding Update messages received (FailoverBndupdReceivedTotal)",
+			nil,
+			nil,
+		)
+		c.failoverBndAckSentTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndack_sent_total"),
+			"Number of DHCP fail over Binding Ack messages sent (FailoverBndackSentTotal)",
+			nil,
+			nil,
+		)
+		c.failoverBndAckReceivedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndack_received_total"),
+			"Number of DHCP fail over Binding Ack messages received (FailoverBndackReceivedTotal)",
+			nil,
+			nil,
+		)
+		c.failoverBndUpdPendingOutboundQueue = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_pending_in_outbound_queue"),
+			"Number of pending outbound DHCP fail over Binding Update messages (FailoverBndupdPendingOutboundQueue)",
+			nil,
+			nil,
+		)
+		c.failoverTransitionsCommunicationInterruptedState = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_communicationinterrupted_state_total"),
+			"Total number of transitions into COMMUNICATION INTERRUPTED state (FailoverTransitionsCommunicationinterruptedState)",
+			nil,
+			nil,
+		)
+		c.failoverTransitionsPartnerDownState = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_partnerdown_state_total"),
+			"Total number of transitions into PARTNER DOWN state (FailoverTransitionsPartnerdownState)",
+			nil,
+			nil,
+		)
+		c.failoverTransitionsRecoverState = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_transitions_recover_total"),
+			"Total number of transitions into RECOVER state (FailoverTransitionsRecoverState)",
+			nil,
+			nil,
+		)
+		c.failoverBndUpdDropped = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "failover_bndupd_dropped_total"),
+			"Total number of DHCP fail over Binding Updates dropped (FailoverBndupdDropped)",
+			nil,
+			nil,
+		)
+
+		c.buildFailover()
+	}
+
+	if err := c.buildV6(); err != nil {
+		return fmt.Errorf("failed to build DHCPv6 Server collector: %w", err)
+	}
+
+	if c.config.ScopesEnabled {
+		c.scopeAddressesInUse = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_addresses_in_use"),
+			"Number of addresses in use in the DHCP scope (AddressesInUse)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopeAddressesFree = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_addresses_free"),
+			"Number of free addresses remaining in the DHCP scope (AddressesFree)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopePercentageInUse = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_percentage_in_use"),
+			"Percentage of addresses in the DHCP scope currently in use (PercentageInUse)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopeReservedAddresses = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_reserved_addresses"),
+			"Number of reserved addresses in the DHCP scope (ReservedAddress)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopeActiveLeases = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_active_leases"),
+			"Number of active leases in the DHCP scope (AddressesInUse minus reservations)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopePendingOffers = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_pending_offers"),
+			"Number of addresses in the DHCP scope offered to clients but not yet acknowledged (PendingOffers)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+		c.scopeUtilizationRatio = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, Name, "scope_utilization_ratio"),
+			"Ratio (0-1) of addresses in the DHCP scope currently in use (PercentageInUse/100)",
+			[]string{"scope_id", "scope_name", "superscope"},
+			nil,
+		)
+
+		if c.config.ScopeUtilizationHistogramEnabled {
+			c.scopeUtilizationHistogram = prometheus.NewDesc(
+				prometheus.BuildFQName(types.Namespace, Name, "scope_utilization_ratio_histogram"),
+				"Distribution of DHCP scopes by percent utilization across this scrape, for fleet-wide exhaustion alerting",
+				nil,
+				nil,
+			)
+		}
+	}
 
 	return nil
 }
 
 func (c *Collector) Collect(ctx *types.ScrapeContext, logger *slog.Logger, ch chan<- prometheus.Metric) error {
+	logger = logger.With(slog.String("collector", Name))
+
+	var err error
+
 	if utils.PDHEnabled() {
-		return c.collectPDH(ch)
+		err = c.collectPDH(ch)
+	} else {
+		err = c.collect(ctx, logger, ch)
 	}
 
-	logger = logger.With(slog.String("collector", Name))
+	if err != nil {
+		return err
+	}
 
-	return c.collect(ctx, logger, ch)
+	if c.config.ScopesEnabled {
+		if err := c.collectScopes(logger, ch); err != nil {
+			return fmt.Errorf("failed to collect DHCP scope statistics: %w", err)
+		}
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFailover) {
+		if err := c.collectFailoverRelationships(logger, ch); err != nil {
+			return fmt.Errorf("failed to collect DHCP failover relationships: %w", err)
+		}
+	}
+
+	if err := c.collectV6(ctx, logger, ch); err != nil {
+		return fmt.Errorf("failed to collect DHCPv6 Server metrics: %w", err)
+	}
+
+	return nil
 }
 
 func (c *Collector) collect(ctx *types.ScrapeContext, logger *slog.Logger, ch chan<- prometheus.Metric) error {
@@ -297,155 +580,163 @@ func (c *Collector) collect(ctx *types.ScrapeContext, logger *slog.Logger, ch ch
 		return err
 	}
 
-	ch <- prometheus.MustNewConstMetric(
-		c.packetsReceivedTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].PacketsReceivedTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.duplicatesDroppedTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].DuplicatesDroppedTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.packetsExpiredTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].PacketsExpiredTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.activeQueueLength,
-		prometheus.GaugeValue,
-		dhcpPerfs[0].ActiveQueueLength,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.conflictCheckQueueLength,
-		prometheus.GaugeValue,
-		dhcpPerfs[0].ConflictCheckQueueLength,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.discoversTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].DiscoversTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.offersTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].OffersTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.requestsTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].RequestsTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.informsTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].InformsTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.acksTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].AcksTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.nACKsTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].NacksTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.declinesTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].DeclinesTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.releasesTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].ReleasesTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.offerQueueLength,
-		prometheus.GaugeValue,
-		dhcpPerfs[0].OfferQueueLength,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.deniedDueToMatch,
-		prometheus.CounterValue,
-		dhcpPerfs[0].DeniedDueToMatch,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.deniedDueToNonMatch,
-		prometheus.CounterValue,
-		dhcpPerfs[0].DeniedDueToNonMatch,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdSentTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverBndUpdSentTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdReceivedTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverBndUpdReceivedTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndAckSentTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverBndAckSentTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndAckReceivedTotal,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverBndAckReceivedTotal,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdPendingOutboundQueue,
-		prometheus.GaugeValue,
-		dhcpPerfs[0].FailoverBndUpdPendingOutboundQueue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsCommunicationInterruptedState,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverTransitionsCommunicationInterruptedState,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsPartnerDownState,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverTransitionsPartnerDownState,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsRecoverState,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverTransitionsRecoverState,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdDropped,
-		prometheus.CounterValue,
-		dhcpPerfs[0].FailoverBndUpdDropped,
-	)
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		ch <- prometheus.MustNewConstMetric(
+			c.packetsReceivedTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].PacketsReceivedTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.duplicatesDroppedTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].DuplicatesDroppedTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.packetsExpiredTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].PacketsExpiredTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.discoversTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].DiscoversTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.offersTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].OffersTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.requestsTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].RequestsTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.informsTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].InformsTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.acksTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].AcksTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.nACKsTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].NacksTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.declinesTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].DeclinesTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.releasesTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].ReleasesTotal,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		ch <- prometheus.MustNewConstMetric(
+			c.activeQueueLength,
+			prometheus.GaugeValue,
+			dhcpPerfs[0].ActiveQueueLength,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.conflictCheckQueueLength,
+			prometheus.GaugeValue,
+			dhcpPerfs[0].ConflictCheckQueueLength,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.offerQueueLength,
+			prometheus.GaugeValue,
+			dhcpPerfs[0].OfferQueueLength,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFilters) {
+		ch <- prometheus.MustNewConstMetric(
+			c.deniedDueToMatch,
+			prometheus.CounterValue,
+			dhcpPerfs[0].DeniedDueToMatch,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.deniedDueToNonMatch,
+			prometheus.CounterValue,
+			dhcpPerfs[0].DeniedDueToNonMatch,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFailover) {
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdSentTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverBndUpdSentTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdReceivedTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverBndUpdReceivedTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndAckSentTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverBndAckSentTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndAckReceivedTotal,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverBndAckReceivedTotal,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdPendingOutboundQueue,
+			prometheus.GaugeValue,
+			dhcpPerfs[0].FailoverBndUpdPendingOutboundQueue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsCommunicationInterruptedState,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverTransitionsCommunicationInterruptedState,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsPartnerDownState,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverTransitionsPartnerDownState,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsRecoverState,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverTransitionsRecoverState,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdDropped,
+			prometheus.CounterValue,
+			dhcpPerfs[0].FailoverBndUpdDropped,
+		)
+	}
 
 	return nil
 }
@@ -461,155 +752,163 @@ func (c *Collector) collectPDH(ch chan<- prometheus.Metric) error {
 		return errors.New("perflib query for DHCP Server returned empty result set")
 	}
 
-	ch <- prometheus.MustNewConstMetric(
-		c.packetsReceivedTotal,
-		prometheus.CounterValue,
-		data[packetsReceivedTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.duplicatesDroppedTotal,
-		prometheus.CounterValue,
-		data[duplicatesDroppedTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.packetsExpiredTotal,
-		prometheus.CounterValue,
-		data[packetsExpiredTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.activeQueueLength,
-		prometheus.GaugeValue,
-		data[activeQueueLength].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.conflictCheckQueueLength,
-		prometheus.GaugeValue,
-		data[conflictCheckQueueLength].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.discoversTotal,
-		prometheus.CounterValue,
-		data[discoversTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.offersTotal,
-		prometheus.CounterValue,
-		data[offersTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.requestsTotal,
-		prometheus.CounterValue,
-		data[requestsTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.informsTotal,
-		prometheus.CounterValue,
-		data[informsTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.acksTotal,
-		prometheus.CounterValue,
-		data[acksTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.nACKsTotal,
-		prometheus.CounterValue,
-		data[nacksTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.declinesTotal,
-		prometheus.CounterValue,
-		data[declinesTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.releasesTotal,
-		prometheus.CounterValue,
-		data[releasesTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.offerQueueLength,
-		prometheus.GaugeValue,
-		data[offerQueueLength].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.deniedDueToMatch,
-		prometheus.CounterValue,
-		data[deniedDueToMatch].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.deniedDueToNonMatch,
-		prometheus.CounterValue,
-		data[deniedDueToNonMatch].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdSentTotal,
-		prometheus.CounterValue,
-		data[failoverBndUpdSentTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdReceivedTotal,
-		prometheus.CounterValue,
-		data[failoverBndUpdReceivedTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndAckSentTotal,
-		prometheus.CounterValue,
-		data[failoverBndAckSentTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndAckReceivedTotal,
-		prometheus.CounterValue,
-		data[failoverBndAckReceivedTotal].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdPendingOutboundQueue,
-		prometheus.GaugeValue,
-		data[failoverBndUpdPendingOutboundQueue].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsCommunicationInterruptedState,
-		prometheus.CounterValue,
-		data[failoverTransitionsCommunicationInterruptedState].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsPartnerDownState,
-		prometheus.CounterValue,
-		data[failoverTransitionsPartnerDownState].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverTransitionsRecoverState,
-		prometheus.CounterValue,
-		data[failoverTransitionsRecoverState].FirstValue,
-	)
-
-	ch <- prometheus.MustNewConstMetric(
-		c.failoverBndUpdDropped,
-		prometheus.CounterValue,
-		data[failoverBndUpdDropped].FirstValue,
-	)
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		ch <- prometheus.MustNewConstMetric(
+			c.packetsReceivedTotal,
+			prometheus.CounterValue,
+			data[packetsReceivedTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.duplicatesDroppedTotal,
+			prometheus.CounterValue,
+			data[duplicatesDroppedTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.packetsExpiredTotal,
+			prometheus.CounterValue,
+			data[packetsExpiredTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.discoversTotal,
+			prometheus.CounterValue,
+			data[discoversTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.offersTotal,
+			prometheus.CounterValue,
+			data[offersTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.requestsTotal,
+			prometheus.CounterValue,
+			data[requestsTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.informsTotal,
+			prometheus.CounterValue,
+			data[informsTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.acksTotal,
+			prometheus.CounterValue,
+			data[acksTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.nACKsTotal,
+			prometheus.CounterValue,
+			data[nacksTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.declinesTotal,
+			prometheus.CounterValue,
+			data[declinesTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.releasesTotal,
+			prometheus.CounterValue,
+			data[releasesTotal].FirstValue,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		ch <- prometheus.MustNewConstMetric(
+			c.activeQueueLength,
+			prometheus.GaugeValue,
+			data[activeQueueLength].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.conflictCheckQueueLength,
+			prometheus.GaugeValue,
+			data[conflictCheckQueueLength].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.offerQueueLength,
+			prometheus.GaugeValue,
+			data[offerQueueLength].FirstValue,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFilters) {
+		ch <- prometheus.MustNewConstMetric(
+			c.deniedDueToMatch,
+			prometheus.CounterValue,
+			data[deniedDueToMatch].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.deniedDueToNonMatch,
+			prometheus.CounterValue,
+			data[deniedDueToNonMatch].FirstValue,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupFailover) {
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdSentTotal,
+			prometheus.CounterValue,
+			data[failoverBndUpdSentTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdReceivedTotal,
+			prometheus.CounterValue,
+			data[failoverBndUpdReceivedTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndAckSentTotal,
+			prometheus.CounterValue,
+			data[failoverBndAckSentTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndAckReceivedTotal,
+			prometheus.CounterValue,
+			data[failoverBndAckReceivedTotal].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdPendingOutboundQueue,
+			prometheus.GaugeValue,
+			data[failoverBndUpdPendingOutboundQueue].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsCommunicationInterruptedState,
+			prometheus.CounterValue,
+			data[failoverTransitionsCommunicationInterruptedState].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsPartnerDownState,
+			prometheus.CounterValue,
+			data[failoverTransitionsPartnerDownState].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverTransitionsRecoverState,
+			prometheus.CounterValue,
+			data[failoverTransitionsRecoverState].FirstValue,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverBndUpdDropped,
+			prometheus.CounterValue,
+			data[failoverBndUpdDropped].FirstValue,
+		)
+	}
 
 	return nil
 }
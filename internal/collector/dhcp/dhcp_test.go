@@ -0,0 +1,74 @@
+//go:build windows
+
+package dhcp
+
+import "testing"
+
+func TestIsMetricGroupEnabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		enabled  []string
+		group    string
+		expected bool
+	}{
+		{"empty enables everything", nil, MetricGroupPackets, true},
+		{"matching group", []string{MetricGroupQueues, MetricGroupFailover}, MetricGroupFailover, true},
+		{"non-matching group", []string{MetricGroupQueues}, MetricGroupFailover, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Collector{config: Config{EnabledMetrics: test.enabled}}
+
+			if got := c.isMetricGroupEnabled(test.group); got != test.expected {
+				t.Errorf("isMetricGroupEnabled(%q) = %v, want %v", test.group, got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCSVStringsValueSet(t *testing.T) {
+	t.Parallel()
+
+	var target []string
+
+	value := newCSVStringsValue(&target, enabledMetricGroups)
+
+	if got := target; len(got) != len(enabledMetricGroups) {
+		t.Fatalf("default value = %v, want %v", got, enabledMetricGroups)
+	}
+
+	if err := value.Set("packets, queues"); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+
+	expected := []string{MetricGroupPackets, MetricGroupQueues}
+	if len(target) != len(expected) || target[0] != expected[0] || target[1] != expected[1] {
+		t.Fatalf("after first Set, target = %v, want %v", target, expected)
+	}
+
+	// A second occurrence of the flag (as kingpin would invoke Set for a
+	// repeated --collector.dhcp.enabled) must accumulate, not replace.
+	if err := value.Set("failover"); err != nil {
+		t.Fatalf("Set returned error: %v", err)
+	}
+
+	expected = []string{MetricGroupPackets, MetricGroupQueues, MetricGroupFailover}
+	if len(target) != len(expected) {
+		t.Fatalf("after second Set, target = %v, want %v", target, expected)
+	}
+
+	for i, group := range expected {
+		if target[i] != group {
+			t.Errorf("target[%d] = %q, want %q", i, target[i], group)
+		}
+	}
+
+	if !value.IsCumulative() {
+		t.Error("IsCumulative() = false, want true so repeated flag occurrences accumulate")
+	}
+}
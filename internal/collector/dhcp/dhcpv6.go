@@ -0,0 +1,254 @@
+//go:build windows
+
+package dhcp
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus-community/windows_exporter/internal/perfdata"
+	"github.com/prometheus-community/windows_exporter/internal/perfdata/perftypes"
+	v1 "github.com/prometheus-community/windows_exporter/internal/perfdata/v1"
+	"github.com/prometheus-community/windows_exporter/internal/types"
+	"github.com/prometheus-community/windows_exporter/internal/utils"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// subsystemV6 is the metric subsystem used for the IPv6 counterpart of the
+// "DHCP Server" perflib object, "DHCPv6 Server".
+const subsystemV6 = Name + "v6"
+
+// dhcpv6Perf mirrors the "DHCPv6 Server" perflib object.
+type dhcpv6Perf struct {
+	PacketsReceivedTotal     float64 `perflib:"Packets Received"`
+	DuplicatesDroppedTotal   float64 `perflib:"Receive Duplicates"`
+	ActiveQueueLength        float64 `perflib:"Active Queue Length"`
+	SolicitsTotal            float64 `perflib:"Solicits Received"`
+	AdvertisesTotal          float64 `perflib:"Advertises Sent"`
+	RequestsTotal            float64 `perflib:"Requests Received"`
+	RepliesTotal             float64 `perflib:"Replies Sent"`
+	RenewsTotal              float64 `perflib:"Renews Received"`
+	RebindsTotal             float64 `perflib:"Rebinds Received"`
+	InformationRequestsTotal float64 `perflib:"Information Requests Received"`
+	ReleasesTotal            float64 `perflib:"Releases Received"`
+	DeclinesTotal            float64 `perflib:"Declines Received"`
+}
+
+const (
+	v6PacketsReceivedTotal     = "Packets Received"
+	v6DuplicatesDroppedTotal   = "Receive Duplicates"
+	v6ActiveQueueLength        = "Active Queue Length"
+	v6SolicitsTotal            = "Solicits Received"
+	v6AdvertisesTotal          = "Advertises Sent"
+	v6RequestsTotal            = "Requests Received"
+	v6RepliesTotal             = "Replies Sent"
+	v6RenewsTotal              = "Renews Received"
+	v6RebindsTotal             = "Rebinds Received"
+	v6InformationRequestsTotal = "Information Requests Received"
+	v6ReleasesTotal            = "Releases Received"
+	v6DeclinesTotal            = "Declines Received"
+)
+
+// buildV6 creates the descs and, when PDH is in use, the perfdata collector
+// for the "DHCPv6 Server" perflib object. Gated behind MetricGroupPackets /
+// MetricGroupQueues the same way the v4 object is, since DHCPv6 Server
+// exposes no failover or filter counters.
+func (c *Collector) buildV6() error {
+	if utils.PDHEnabled() {
+		var counters []string
+
+		if c.isMetricGroupEnabled(MetricGroupPackets) {
+			counters = append(counters,
+				v6PacketsReceivedTotal,
+				v6DuplicatesDroppedTotal,
+				v6SolicitsTotal,
+				v6AdvertisesTotal,
+				v6RequestsTotal,
+				v6RepliesTotal,
+				v6RenewsTotal,
+				v6RebindsTotal,
+				v6InformationRequestsTotal,
+				v6ReleasesTotal,
+				v6DeclinesTotal,
+			)
+		}
+
+		if c.isMetricGroupEnabled(MetricGroupQueues) {
+			counters = append(counters, v6ActiveQueueLength)
+		}
+
+		if len(counters) == 0 {
+			return nil
+		}
+
+		var err error
+
+		c.perfDataCollectorV6, err = perfdata.NewCollector(perfdata.V1, "DHCPv6 Server", perfdata.AllInstances, counters)
+		if err != nil {
+			return fmt.Errorf("failed to create DHCPv6 Server collector: %w", err)
+		}
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		c.v6PacketsReceivedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "packets_received_total"),
+			"Total number of packets received by the DHCPv6 server (PacketsReceivedTotal)",
+			nil,
+			nil,
+		)
+		c.v6DuplicatesDroppedTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "duplicates_dropped_total"),
+			"Total number of duplicate packets received by the DHCPv6 server (DuplicatesDroppedTotal)",
+			nil,
+			nil,
+		)
+		c.v6SolicitsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "solicits_total"),
+			"Total DHCPv6 Solicits received by the DHCPv6 server (SolicitsTotal)",
+			nil,
+			nil,
+		)
+		c.v6AdvertisesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "advertises_total"),
+			"Total DHCPv6 Advertises sent by the DHCPv6 server (AdvertisesTotal)",
+			nil,
+			nil,
+		)
+		c.v6RequestsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "requests_total"),
+			"Total DHCPv6 Requests received by the DHCPv6 server (RequestsTotal)",
+			nil,
+			nil,
+		)
+		c.v6RepliesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "replies_total"),
+			"Total DHCPv6 Replies sent by the DHCPv6 server (RepliesTotal)",
+			nil,
+			nil,
+		)
+		c.v6RenewsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "renews_total"),
+			"Total DHCPv6 Renews received by the DHCPv6 server (RenewsTotal)",
+			nil,
+			nil,
+		)
+		c.v6RebindsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "rebinds_total"),
+			"Total DHCPv6 Rebinds received by the DHCPv6 server (RebindsTotal)",
+			nil,
+			nil,
+		)
+		c.v6InformationRequestsTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "information_requests_total"),
+			"Total DHCPv6 Information Requests received by the DHCPv6 server (InformationRequestsTotal)",
+			nil,
+			nil,
+		)
+		c.v6ReleasesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "releases_total"),
+			"Total DHCPv6 Releases received by the DHCPv6 server (ReleasesTotal)",
+			nil,
+			nil,
+		)
+		c.v6DeclinesTotal = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "declines_total"),
+			"Total DHCPv6 Declines received by the DHCPv6 server (DeclinesTotal)",
+			nil,
+			nil,
+		)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		c.v6ActiveQueueLength = prometheus.NewDesc(
+			prometheus.BuildFQName(types.Namespace, subsystemV6, "active_queue_length"),
+			"Number of packets in the processing queue of the DHCPv6 server (ActiveQueueLength)",
+			nil,
+			nil,
+		)
+	}
+
+	return nil
+}
+
+// collectV6 collects the "DHCPv6 Server" perflib/PDH object, mirroring
+// collect/collectPDH for the v4 object.
+func (c *Collector) collectV6(ctx *types.ScrapeContext, logger *slog.Logger, ch chan<- prometheus.Metric) error {
+	if !c.isMetricGroupEnabled(MetricGroupPackets) && !c.isMetricGroupEnabled(MetricGroupQueues) {
+		return nil
+	}
+
+	if utils.PDHEnabled() {
+		return c.collectV6PDH(ch)
+	}
+
+	return c.collectV6Perflib(ctx, logger, ch)
+}
+
+func (c *Collector) collectV6Perflib(ctx *types.ScrapeContext, logger *slog.Logger, ch chan<- prometheus.Metric) error {
+	var dhcpv6Perfs []dhcpv6Perf
+
+	if err := v1.UnmarshalObject(ctx.PerfObjects["DHCPv6 Server"], &dhcpv6Perfs, logger); err != nil {
+		return err
+	}
+
+	if len(dhcpv6Perfs) == 0 {
+		return nil
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		ch <- prometheus.MustNewConstMetric(c.v6PacketsReceivedTotal, prometheus.CounterValue, dhcpv6Perfs[0].PacketsReceivedTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6DuplicatesDroppedTotal, prometheus.CounterValue, dhcpv6Perfs[0].DuplicatesDroppedTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6SolicitsTotal, prometheus.CounterValue, dhcpv6Perfs[0].SolicitsTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6AdvertisesTotal, prometheus.CounterValue, dhcpv6Perfs[0].AdvertisesTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6RequestsTotal, prometheus.CounterValue, dhcpv6Perfs[0].RequestsTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6RepliesTotal, prometheus.CounterValue, dhcpv6Perfs[0].RepliesTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6RenewsTotal, prometheus.CounterValue, dhcpv6Perfs[0].RenewsTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6RebindsTotal, prometheus.CounterValue, dhcpv6Perfs[0].RebindsTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6InformationRequestsTotal, prometheus.CounterValue, dhcpv6Perfs[0].InformationRequestsTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6ReleasesTotal, prometheus.CounterValue, dhcpv6Perfs[0].ReleasesTotal)
+		ch <- prometheus.MustNewConstMetric(c.v6DeclinesTotal, prometheus.CounterValue, dhcpv6Perfs[0].DeclinesTotal)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		ch <- prometheus.MustNewConstMetric(c.v6ActiveQueueLength, prometheus.GaugeValue, dhcpv6Perfs[0].ActiveQueueLength)
+	}
+
+	return nil
+}
+
+func (c *Collector) collectV6PDH(ch chan<- prometheus.Metric) error {
+	if c.perfDataCollectorV6 == nil {
+		return nil
+	}
+
+	perfData, err := c.perfDataCollectorV6.Collect()
+	if err != nil {
+		return fmt.Errorf("failed to collect DHCPv6 Server metrics: %w", err)
+	}
+
+	data, ok := perfData[perftypes.EmptyInstance]
+	if !ok {
+		return errors.New("perflib query for DHCPv6 Server returned empty result set")
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupPackets) {
+		ch <- prometheus.MustNewConstMetric(c.v6PacketsReceivedTotal, prometheus.CounterValue, data[v6PacketsReceivedTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6DuplicatesDroppedTotal, prometheus.CounterValue, data[v6DuplicatesDroppedTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6SolicitsTotal, prometheus.CounterValue, data[v6SolicitsTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6AdvertisesTotal, prometheus.CounterValue, data[v6AdvertisesTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6RequestsTotal, prometheus.CounterValue, data[v6RequestsTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6RepliesTotal, prometheus.CounterValue, data[v6RepliesTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6RenewsTotal, prometheus.CounterValue, data[v6RenewsTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6RebindsTotal, prometheus.CounterValue, data[v6RebindsTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6InformationRequestsTotal, prometheus.CounterValue, data[v6InformationRequestsTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6ReleasesTotal, prometheus.CounterValue, data[v6ReleasesTotal].FirstValue)
+		ch <- prometheus.MustNewConstMetric(c.v6DeclinesTotal, prometheus.CounterValue, data[v6DeclinesTotal].FirstValue)
+	}
+
+	if c.isMetricGroupEnabled(MetricGroupQueues) {
+		ch <- prometheus.MustNewConstMetric(c.v6ActiveQueueLength, prometheus.GaugeValue, data[v6ActiveQueueLength].FirstValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+//go:build windows
+
+package dhcp
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus-community/windows_exporter/internal/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// miQueryDHCPv4Failover is the MI query backing Get-DhcpServerv4Failover.
+const miQueryDHCPv4Failover = "SELECT * FROM MSFT_DhcpServerv4Failover"
+
+// dhcpFailoverStates enumerates the states a DHCP failover relationship can
+// report, in the order Get-DhcpServerv4Failover returns them.
+var dhcpFailoverStates = []string{
+	"NORMAL",
+	"COMMUNICATION-INTERRUPTED",
+	"PARTNER-DOWN",
+	"RECOVER",
+	"RECOVER-WAIT",
+	"RECOVER-DONE",
+}
+
+// dhcpServerv4FailoverRelationship mirrors the fields of
+// Get-DhcpServerv4Failover that are needed to report current relationship
+// health, one row per scope covered by the relationship.
+type dhcpServerv4FailoverRelationship struct {
+	Name                   string
+	PartnerServer          string
+	ScopeID                string
+	Mode                   string
+	State                  string
+	MaxClientLeadTime      float64
+	LoadBalancePercent     float64
+	ReservePercent         float64
+	StateSwitchoverSeconds float64
+}
+
+func (c *Collector) buildFailover() {
+	c.failoverRelationshipState = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_relationship_state"),
+		"Current state of the DHCP failover relationship, 1 for the active state and 0 otherwise (State)",
+		[]string{"relationship_name", "partner_server", "scope_id", "state"},
+		nil,
+	)
+	c.failoverRelationshipInfo = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_relationship_info"),
+		"Static information about a configured DHCP failover relationship, value is always 1 (Mode)",
+		[]string{"relationship_name", "partner_server", "scope_id", "mode"},
+		nil,
+	)
+	c.failoverMCLTSeconds = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_mclt_seconds"),
+		"Maximum Client Lead Time configured for the DHCP failover relationship (MaxClientLeadTime)",
+		[]string{"relationship_name", "partner_server", "scope_id"},
+		nil,
+	)
+	c.failoverLoadBalancePercent = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_load_balance_percent"),
+		"Percentage of DHCP client requests handled by this server in load-balance mode (LoadBalancePercent)",
+		[]string{"relationship_name", "partner_server", "scope_id"},
+		nil,
+	)
+	c.failoverReservePercent = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_reserve_percent"),
+		"Percentage of free addresses reserved for the standby server in hot-standby mode (ReservePercent)",
+		[]string{"relationship_name", "partner_server", "scope_id"},
+		nil,
+	)
+	c.failoverStateSinceSeconds = prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "failover_state_since_seconds"),
+		"Number of seconds since the DHCP failover relationship last changed state",
+		[]string{"relationship_name", "partner_server", "scope_id"},
+		nil,
+	)
+}
+
+// collectFailoverRelationships queries the configured DHCP failover
+// relationships and emits the current state, mode and timing gauges for
+// each scope covered by a relationship.
+func (c *Collector) collectFailoverRelationships(logger *slog.Logger, ch chan<- prometheus.Metric) error {
+	relationships, err := c.queryFailoverRelationships()
+	if err != nil {
+		return fmt.Errorf("failed to query DHCP failover relationships: %w", err)
+	}
+
+	for _, relationship := range relationships {
+		labels := []string{relationship.Name, relationship.PartnerServer, relationship.ScopeID}
+
+		for _, state := range dhcpFailoverStates {
+			value := 0.0
+			if state == relationship.State {
+				value = 1.0
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				c.failoverRelationshipState,
+				prometheus.GaugeValue,
+				value,
+				append(labels, state)...,
+			)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverRelationshipInfo,
+			prometheus.GaugeValue,
+			1,
+			append(labels, relationship.Mode)...,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverMCLTSeconds,
+			prometheus.GaugeValue,
+			relationship.MaxClientLeadTime,
+			labels...,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverLoadBalancePercent,
+			prometheus.GaugeValue,
+			relationship.LoadBalancePercent,
+			labels...,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverReservePercent,
+			prometheus.GaugeValue,
+			relationship.ReservePercent,
+			labels...,
+		)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.failoverStateSinceSeconds,
+			prometheus.GaugeValue,
+			relationship.StateSwitchoverSeconds,
+			labels...,
+		)
+	}
+
+	logger.Debug("collected DHCP failover relationships",
+		slog.Int("relationships", len(relationships)),
+	)
+
+	return nil
+}
+
+// queryFailoverRelationships enumerates the configured DHCP failover
+// relationships via the MI session handed to Build.
+func (c *Collector) queryFailoverRelationships() ([]dhcpServerv4FailoverRelationship, error) {
+	var relationships []dhcpServerv4FailoverRelationship
+
+	if err := c.miSession.Query(&relationships, dhcpMINamespace, miQueryDHCPv4Failover); err != nil {
+		return nil, fmt.Errorf("failed to query Get-DhcpServerv4Failover: %w", err)
+	}
+
+	return relationships, nil
+}
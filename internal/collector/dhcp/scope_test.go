@@ -0,0 +1,129 @@
+//go:build windows
+
+package dhcp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus-community/windows_exporter/internal/types"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestBuildScopeUtilizationHistogram(t *testing.T) {
+	t.Parallel()
+
+	desc := prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "scope_utilization_ratio_histogram"),
+		"test",
+		nil,
+		nil,
+	)
+
+	scopes := []dhcpScopeMetrics{
+		{ScopeID: "10.0.0.0/24", PercentageInUse: 40},  // ratio 0.40
+		{ScopeID: "10.0.1.0/24", PercentageInUse: 96},  // ratio 0.96
+		{ScopeID: "10.0.2.0/24", PercentageInUse: 100}, // ratio 1.00
+	}
+
+	metric := buildScopeUtilizationHistogram(desc, scopes)
+
+	var pb dto.Metric
+	if err := metric.Write(&pb); err != nil {
+		t.Fatalf("failed to write metric: %v", err)
+	}
+
+	histogram := pb.GetHistogram()
+	if histogram == nil {
+		t.Fatal("expected a histogram metric")
+	}
+
+	if got, want := histogram.GetSampleCount(), uint64(3); got != want {
+		t.Errorf("sample count = %d, want %d", got, want)
+	}
+
+	if got, want := histogram.GetSampleSum(), 0.40+0.96+1.00; !floatsEqual(got, want) {
+		t.Errorf("sample sum = %v, want %v", got, want)
+	}
+
+	cumulativeByBound := make(map[float64]uint64, len(histogram.Bucket))
+	for _, bucket := range histogram.Bucket {
+		cumulativeByBound[bucket.GetUpperBound()] = bucket.GetCumulativeCount()
+	}
+
+	// A 0.40 scope is counted in every bucket from 0.5 up; a 0.96 scope
+	// only in 0.99 and 1.0; a 1.00 scope only in the 1.0 bucket.
+	expected := map[float64]uint64{
+		0.5:  1,
+		0.75: 1,
+		0.9:  1,
+		0.95: 1,
+		0.99: 2,
+		1.0:  3,
+	}
+
+	for bound, want := range expected {
+		if got := cumulativeByBound[bound]; got != want {
+			t.Errorf("cumulative count for bucket %v = %d, want %d", bound, got, want)
+		}
+	}
+}
+
+func TestBuildScopeUtilizationHistogramEmpty(t *testing.T) {
+	t.Parallel()
+
+	desc := prometheus.NewDesc(
+		prometheus.BuildFQName(types.Namespace, Name, "scope_utilization_ratio_histogram"),
+		"test",
+		nil,
+		nil,
+	)
+
+	metric := buildScopeUtilizationHistogram(desc, nil)
+
+	var pb dto.Metric
+	if err := metric.Write(&pb); err != nil {
+		t.Fatalf("failed to write metric: %v", err)
+	}
+
+	if got := pb.GetHistogram().GetSampleCount(); got != 0 {
+		t.Errorf("sample count = %d, want 0", got)
+	}
+}
+
+func floatsEqual(a, b float64) bool {
+	const epsilon = 1e-9
+
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff < epsilon
+}
+
+func TestScopeV6ToScopeMetricsUsesPrefixAsScopeID(t *testing.T) {
+	t.Parallel()
+
+	v6 := dhcpServerv6ScopeStatistics{
+		Prefix:          "2001:db8::/64",
+		AddressesFree:   10,
+		AddressesInUse:  90,
+		PercentageInUse: 90,
+	}
+
+	got := v6.toScopeMetrics()
+
+	if got.ScopeID != v6.Prefix {
+		t.Errorf("ScopeID = %q, want %q (Prefix)", got.ScopeID, v6.Prefix)
+	}
+
+	if got.Name != "" || got.SuperscopeName != "" {
+		t.Errorf("v6 scopes should not carry a v4 name/superscope, got Name=%q SuperscopeName=%q", got.Name, got.SuperscopeName)
+	}
+
+	if !strings.Contains(got.ScopeID, ":") {
+		t.Errorf("ScopeID = %q, want an IPv6 prefix", got.ScopeID)
+	}
+}